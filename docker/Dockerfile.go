@@ -1,3 +1,4 @@
+# TODO: sdks/go moved to windjammer-game; see docs/GO_SDK_BACKLOG.md
 FROM golang:1.25-alpine
 
 WORKDIR /sdk